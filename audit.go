@@ -0,0 +1,93 @@
+package main
+
+import (
+	"encoding/json"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+)
+
+// maxAuditHistory bounds how many recent entries are kept in memory for the
+// /history endpoint; when AuditLogPath is set, the full history is written
+// there.
+const maxAuditHistory = 200
+
+// AuditEntry records a single scaling decision, applied or not.
+type AuditEntry struct {
+	Timestamp     time.Time `json:"timestamp"`
+	Service       string    `json:"service"`
+	Action        string    `json:"action"`
+	MetricValue   float64   `json:"metric_value"`
+	ThresholdUp   float64   `json:"threshold_up"`
+	ThresholdDown float64   `json:"threshold_down"`
+	CurrentSize   int       `json:"current_size"`
+	TargetSize    int       `json:"target_size"`
+	Applied       bool      `json:"applied"`
+	DryRun        bool      `json:"dry_run"`
+	Reason        string    `json:"reason,omitempty"`
+	Error         string    `json:"error,omitempty"`
+}
+
+// AuditLog is an append-only JSONL record of scaling decisions, plus an
+// in-memory tail used to serve the /history endpoint.
+type AuditLog struct {
+	mu      sync.Mutex
+	file    *os.File
+	history []AuditEntry
+}
+
+// NewAuditLog opens path for appending. An empty path disables the on-disk
+// trail; the in-memory /history tail still works.
+func NewAuditLog(path string) (*AuditLog, error) {
+	if path == "" {
+		return &AuditLog{}, nil
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AuditLog{file: f}, nil
+}
+
+func (a *AuditLog) Record(entry AuditEntry) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.history = append(a.history, entry)
+	if len(a.history) > maxAuditHistory {
+		a.history = a.history[len(a.history)-maxAuditHistory:]
+	}
+
+	if a.file == nil {
+		return
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		slog.Error("marshaling audit entry", "error", err)
+		return
+	}
+	data = append(data, '\n')
+
+	if _, err := a.file.Write(data); err != nil {
+		slog.Error("writing audit log", "error", err)
+	}
+}
+
+// Last returns the most recent n entries, oldest first.
+func (a *AuditLog) Last(n int) []AuditEntry {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if n > len(a.history) {
+		n = len(a.history)
+	}
+
+	out := make([]AuditEntry, n)
+	copy(out, a.history[len(a.history)-n:])
+
+	return out
+}
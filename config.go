@@ -0,0 +1,280 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/joho/godotenv"
+	promV1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"gopkg.in/yaml.v3"
+)
+
+// ScaleTarget describes a single app-platform service and the policy used
+// to scale it.
+type ScaleTarget struct {
+	ServiceName       string        `yaml:"service_name"`
+	PrometheusMetric  string        `yaml:"prometheus_metric"`
+	ThresholdUp       float64       `yaml:"threshold_up"`
+	ThresholdDown     float64       `yaml:"threshold_down"`
+	MinSize           int           `yaml:"min_size"`
+	MaxSize           int           `yaml:"max_size"`
+	StepUp            int           `yaml:"step_up"`
+	StepDown          int           `yaml:"step_down"`
+	ScaleUpCooldown   time.Duration `yaml:"scale_up_cooldown"`
+	ScaleDownCooldown time.Duration `yaml:"scale_down_cooldown"`
+
+	// ScaleUpWindow, when set, requires the metric to stay above
+	// ThresholdUp for the whole window before scaling up. ScaleDownWindow
+	// requires it to stay below ThresholdDown for the whole window before
+	// scaling down, guarding against flapping on a single noisy sample.
+	ScaleUpWindow   time.Duration `yaml:"scale_up_window"`
+	ScaleDownWindow time.Duration `yaml:"scale_down_window"`
+
+	lastScaleUpTime   time.Time
+	lastScaleDownTime time.Time
+}
+
+type Config struct {
+	PrometheusHost  string         `yaml:"prometheus_host"`
+	DOAppID         string         `yaml:"do_app_id"`
+	DOAPIToken      string         `yaml:"-"`
+	PollInterval    time.Duration  `yaml:"poll_interval"`
+	DryRun          bool           `yaml:"dry_run"`
+	AuditLogPath    string         `yaml:"audit_log"`
+	Targets         []*ScaleTarget `yaml:"targets"`
+	prometheusV1API *promV1.API
+	godoClient      *godo.Client
+	simpleWebServer *SimpleWebServer
+	auditLog        *AuditLog
+}
+
+// LoadConfig builds a Config from SCALER_CONFIG (a YAML file listing one or
+// more scaling targets) when set, or falls back to the single-service
+// environment variables for backwards compatibility.
+func LoadConfig() (Config, error) {
+	_ = godotenv.Load()
+
+	var config Config
+	var err error
+
+	if path, ok := os.LookupEnv("SCALER_CONFIG"); ok {
+		config, err = loadConfigFromYAML(path)
+	} else {
+		config, err = loadConfigFromEnv()
+	}
+	if err != nil {
+		return config, err
+	}
+
+	if config.DOAPIToken, _ = os.LookupEnv("DO_API_TOKEN"); config.DOAPIToken == "" {
+		return config, errors.New("DO_API_TOKEN is required")
+	}
+
+	if len(config.Targets) == 0 {
+		return config, errors.New("at least one scaling target is required")
+	}
+
+	for _, target := range config.Targets {
+		if target.MinSize <= 0 {
+			return config, fmt.Errorf("target %q: min_size must be positive", target.ServiceName)
+		}
+		if target.MaxSize < target.MinSize {
+			return config, fmt.Errorf("target %q: max_size must be >= min_size", target.ServiceName)
+		}
+		if target.StepUp <= 0 {
+			target.StepUp = 1
+		}
+		if target.StepDown <= 0 {
+			target.StepDown = 1
+		}
+		if target.ScaleDownWindow <= 0 {
+			target.ScaleDownWindow = 5 * time.Minute
+		}
+	}
+
+	if config.PollInterval <= 0 {
+		config.PollInterval = time.Minute
+	}
+
+	if dryRun, ok := os.LookupEnv("DRY_RUN"); ok {
+		config.DryRun = dryRun == "true"
+	}
+
+	if auditLogPath, ok := os.LookupEnv("AUDIT_LOG"); ok {
+		config.AuditLogPath = auditLogPath
+	}
+
+	auditLog, err := NewAuditLog(config.AuditLogPath)
+	if err != nil {
+		return config, fmt.Errorf("opening audit log: %w", err)
+	}
+	config.auditLog = auditLog
+
+	// todo: make optional
+	simpleWebServer := SimpleWebServer{auditLog: auditLog}
+	simpleWebServer.start()
+
+	config.simpleWebServer = &simpleWebServer
+
+	return config, nil
+}
+
+func loadConfigFromYAML(path string) (Config, error) {
+	var config Config
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return config, fmt.Errorf("reading %s: %w", path, err)
+	}
+
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return config, fmt.Errorf("parsing %s: %w", path, err)
+	}
+
+	if config.PrometheusHost == "" {
+		return config, errors.New("prometheus_host is required")
+	}
+
+	if config.DOAppID == "" {
+		return config, errors.New("do_app_id is required")
+	}
+
+	for _, target := range config.Targets {
+		if target.ServiceName == "" {
+			return config, errors.New("targets: service_name is required")
+		}
+		if target.PrometheusMetric == "" {
+			return config, fmt.Errorf("target %q: prometheus_metric is required", target.ServiceName)
+		}
+		if target.ThresholdUp == 0 {
+			return config, fmt.Errorf("target %q: threshold_up is required", target.ServiceName)
+		}
+		if target.ThresholdDown == 0 {
+			return config, fmt.Errorf("target %q: threshold_down is required", target.ServiceName)
+		}
+	}
+
+	return config, nil
+}
+
+// loadConfigFromEnv reproduces the original single-service configuration so
+// existing deployments keep working without a SCALER_CONFIG file.
+func loadConfigFromEnv() (Config, error) {
+	var config Config
+	var ok bool
+
+	if config.PrometheusHost, ok = os.LookupEnv("PROMETHEUS_HOST"); !ok {
+		return config, errors.New("PROMETHEUS_HOST is required")
+	}
+
+	if config.DOAppID, ok = os.LookupEnv("DO_APP_ID"); !ok {
+		return config, errors.New("DO_APP_ID is required")
+	}
+
+	target := &ScaleTarget{
+		// empty ServiceName keeps the original behaviour of operating on
+		// the app's first service
+		ServiceName: os.Getenv("DO_SERVICE_NAME"),
+		MinSize:     1,
+		StepUp:      1,
+		StepDown:    1,
+	}
+
+	if stepUp := os.Getenv("STEP_UP"); stepUp != "" {
+		var err error
+		target.StepUp, err = strconv.Atoi(stepUp)
+		if err != nil {
+			return config, err
+		}
+	}
+
+	if stepDown := os.Getenv("STEP_DOWN"); stepDown != "" {
+		var err error
+		target.StepDown, err = strconv.Atoi(stepDown)
+		if err != nil {
+			return config, err
+		}
+	}
+
+	if cooldown := os.Getenv("SCALE_UP_COOLDOWN"); cooldown != "" {
+		var err error
+		target.ScaleUpCooldown, err = time.ParseDuration(cooldown)
+		if err != nil {
+			return config, err
+		}
+	}
+
+	if cooldown := os.Getenv("SCALE_DOWN_COOLDOWN"); cooldown != "" {
+		var err error
+		target.ScaleDownCooldown, err = time.ParseDuration(cooldown)
+		if err != nil {
+			return config, err
+		}
+	}
+
+	if window := os.Getenv("SCALE_UP_WINDOW"); window != "" {
+		var err error
+		target.ScaleUpWindow, err = time.ParseDuration(window)
+		if err != nil {
+			return config, err
+		}
+	}
+
+	if window := os.Getenv("SCALE_DOWN_WINDOW"); window != "" {
+		var err error
+		target.ScaleDownWindow, err = time.ParseDuration(window)
+		if err != nil {
+			return config, err
+		}
+	}
+
+	if pollInterval := os.Getenv("POLL_INTERVAL"); pollInterval != "" {
+		var err error
+		config.PollInterval, err = time.ParseDuration(pollInterval)
+		if err != nil {
+			return config, err
+		}
+	}
+
+	if target.PrometheusMetric, ok = os.LookupEnv("PROMETHEUS_METRIC"); !ok {
+		return config, errors.New("PROMETHEUS_METRIC is required")
+	}
+
+	if thresholdUp, ok := os.LookupEnv("THRESHOLD_UP"); !ok {
+		return config, errors.New("THRESHOLD_UP is required")
+	} else {
+		var err error
+		target.ThresholdUp, err = strconv.ParseFloat(thresholdUp, 64)
+		if err != nil {
+			return config, err
+		}
+	}
+
+	if thresholdDown, ok := os.LookupEnv("THRESHOLD_DOWN"); !ok {
+		return config, errors.New("THRESHOLD_DOWN is required")
+	} else {
+		var err error
+		target.ThresholdDown, err = strconv.ParseFloat(thresholdDown, 64)
+		if err != nil {
+			return config, err
+		}
+	}
+
+	if maxSize, ok := os.LookupEnv("MAX_SIZE"); !ok {
+		return config, errors.New("MAX_SIZE is required")
+	} else {
+		var err error
+		target.MaxSize, err = strconv.Atoi(maxSize)
+		if err != nil {
+			return config, err
+		}
+	}
+
+	config.Targets = []*ScaleTarget{target}
+
+	return config, nil
+}
@@ -5,233 +5,546 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"log"
+	"log/slog"
+	"math"
 	"net/http"
 	"os"
+	"os/signal"
 	"strconv"
+	"sync"
+	"syscall"
 	"time"
 
 	"github.com/digitalocean/godo"
-	"github.com/joho/godotenv"
 	promAPI "github.com/prometheus/client_golang/api"
 	promV1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/prometheus/common/model"
 )
 
+// ServiceStatus is the last observed state of a single scaled service.
+type ServiceStatus struct {
+	LastInstanceSize int       `json:"last_instance_size"`
+	LastCheck        time.Time `json:"last_check"`
+}
+
 type SimpleWebServer struct {
-	lastInstanceSize int       `json:"last_instance_size"`
-	lastCheck        time.Time `json:"last_check"`
+	mu       sync.Mutex
+	services map[string]*ServiceStatus
+	srv      *http.Server
+	auditLog *AuditLog
+}
+
+func (sws *SimpleWebServer) recordSize(serviceName string, size int) {
+	sws.mu.Lock()
+	defer sws.mu.Unlock()
+
+	if sws.services == nil {
+		sws.services = make(map[string]*ServiceStatus)
+	}
+
+	sws.services[serviceName] = &ServiceStatus{
+		LastInstanceSize: size,
+		LastCheck:        time.Now(),
+	}
 }
 
 func (sws *SimpleWebServer) start() {
-	log.Printf("Starting web server\n")
+	slog.Info("starting web server")
+
+	mux := http.NewServeMux()
 
-	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
-		json, _ := json.Marshal(sws)
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		sws.mu.Lock()
+		body, _ := json.Marshal(sws.services)
+		sws.mu.Unlock()
 
 		w.Header().Set("Content-Type", "application/json")
-		w.Write(json)
+		w.Write(body)
 	})
 
-	go func() {
-		bindPort := "8080"
-		if os.Getenv("BIND_PORT") != "" {
-			bindPort = os.Getenv("BIND_PORT")
+	mux.Handle("/metrics", promhttp.Handler())
+
+	mux.HandleFunc("/history", func(w http.ResponseWriter, r *http.Request) {
+		n := 50
+		if raw := r.URL.Query().Get("n"); raw != "" {
+			if parsed, err := strconv.Atoi(raw); err == nil && parsed > 0 {
+				n = parsed
+			}
 		}
 
-		if err := http.ListenAndServe(":"+bindPort, nil); err != nil {
-			panic(fmt.Sprintf("Error starting simple webserver: %v", err))
+		var entries []AuditEntry
+		if sws.auditLog != nil {
+			entries = sws.auditLog.Last(n)
+		}
+
+		body, _ := json.Marshal(entries)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(body)
+	})
+
+	bindPort := "8080"
+	if os.Getenv("BIND_PORT") != "" {
+		bindPort = os.Getenv("BIND_PORT")
+	}
+
+	sws.srv = &http.Server{Addr: ":" + bindPort, Handler: mux}
+
+	go func() {
+		if err := sws.srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			slog.Error("web server stopped unexpectedly", "error", err)
 		}
 	}()
 }
 
-type Config struct {
-	PrometheusHost   string
-	ThresholdUp      float64
-	MaxSize          int
-	ThresholdDown    float64
-	DOAPIToken       string
-	DOAppID          string
-	PrometheusMetric string
-	prometheusV1API  *promV1.API
-	godoClient       *godo.Client
-	simpleWebServer  *SimpleWebServer
-}
+func (sws *SimpleWebServer) shutdown(ctx context.Context) error {
+	if sws.srv == nil {
+		return nil
+	}
 
-func LoadConfig() (Config, error) {
-	_ = godotenv.Load()
+	return sws.srv.Shutdown(ctx)
+}
 
-	var config Config
-	var ok bool
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 
-	if config.PrometheusHost, ok = os.LookupEnv("PROMETHEUS_HOST"); !ok {
-		return config, errors.New("PROMETHEUS_HOST is required")
+	config, err := LoadConfig()
+	if err != nil {
+		slog.Error("loading config", "error", err)
+		os.Exit(1)
 	}
 
-	if thresholdUp, ok := os.LookupEnv("THRESHOLD_UP"); !ok {
-		return config, errors.New("THRESHOLD_UP is required")
-	} else {
-		var err error
-		config.ThresholdUp, err = strconv.ParseFloat(thresholdUp, 64)
-		if err != nil {
-			return config, err
+	ticker := time.NewTicker(config.PollInterval)
+	defer ticker.Stop()
+
+	slog.Info("scaler started", "app_id", config.DOAppID, "poll_interval", config.PollInterval)
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.Info("shutdown signal received, stopping")
+
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			if err := config.simpleWebServer.shutdown(shutdownCtx); err != nil {
+				slog.Error("shutting down web server", "error", err)
+			}
+			cancel()
+
+			return
+		case <-ticker.C:
+			config.tick(ctx)
 		}
 	}
+}
 
-	if maxSize, ok := os.LookupEnv("MAX_SIZE"); !ok {
-		return config, errors.New("MAX_SIZE is required")
-	} else {
-		var err error
-		config.MaxSize, err = strconv.Atoi(maxSize)
+// tick evaluates every scaling target once and acts on any threshold breach.
+func (c *Config) tick(ctx context.Context) {
+	for _, target := range c.Targets {
+		log := slog.With("app_id", c.DOAppID, "service", target.ServiceName)
+
+		var currentValue float64
+		err := retryWithBackoff(3, time.Second, func() error {
+			var err error
+			currentValue, err = c.getMetric(ctx, target.ServiceName, target.PrometheusMetric)
+			return err
+		})
 		if err != nil {
-			return config, err
+			log.Warn("skipping tick, could not read metric", "error", err)
+			scaleErrorsTotal.WithLabelValues(target.ServiceName, "metric_query").Inc()
+			continue
+		}
+
+		log.Info("evaluated metric", "current_value", currentValue)
+
+		var scaleErr error
+		var action string
+		switch {
+		case currentValue > target.ThresholdUp:
+			action = "scale_up"
+
+			sustained := true
+			if target.ScaleUpWindow > 0 {
+				var values []float64
+				rangeErr := retryWithBackoff(3, time.Second, func() error {
+					var err error
+					values, err = c.getRangeMetric(ctx, target.ServiceName, target.PrometheusMetric, target.ScaleUpWindow)
+					return err
+				})
+				if rangeErr != nil {
+					log.Warn("skipping scale-up, could not evaluate sustained breach", "error", rangeErr)
+					scaleErrorsTotal.WithLabelValues(target.ServiceName, "range_query").Inc()
+					continue
+				}
+				sustained = minOf(values) > target.ThresholdUp
+			}
+
+			if sustained {
+				scaleErr = c.scaleUp(ctx, target, currentValue)
+			} else {
+				log.Info("scale-up guard: breach not sustained", "window", target.ScaleUpWindow)
+			}
+		case currentValue < target.ThresholdDown:
+			action = "scale_down"
+
+			var values []float64
+			rangeErr := retryWithBackoff(3, time.Second, func() error {
+				var err error
+				values, err = c.getRangeMetric(ctx, target.ServiceName, target.PrometheusMetric, target.ScaleDownWindow)
+				return err
+			})
+			if rangeErr != nil {
+				log.Warn("skipping scale-down, could not evaluate guard", "error", rangeErr)
+				scaleErrorsTotal.WithLabelValues(target.ServiceName, "range_query").Inc()
+				continue
+			}
+
+			if maxOf(values) < target.ThresholdDown {
+				scaleErr = c.scaleDown(ctx, target, currentValue)
+			} else {
+				log.Info("scale-down guard: metric rose above threshold within window", "window", target.ScaleDownWindow)
+			}
+		}
+
+		if scaleErr != nil {
+			log.Error("scaling action failed", "action", action, "current_value", currentValue, "error", scaleErr)
+			scaleErrorsTotal.WithLabelValues(target.ServiceName, action).Inc()
 		}
 	}
+}
 
-	if thresholdDown, ok := os.LookupEnv("THRESHOLD_DOWN"); !ok {
-		return config, errors.New("THRESHOLD_DOWN is required")
-	} else {
-		var err error
-		config.ThresholdDown, err = strconv.ParseFloat(thresholdDown, 64)
-		if err != nil {
-			return config, err
+// retryWithBackoff calls fn up to attempts times, doubling the delay between
+// attempts, and returns the last error if every attempt fails.
+func retryWithBackoff(attempts int, initialDelay time.Duration, fn func() error) error {
+	var err error
+	delay := initialDelay
+
+	for i := 0; i < attempts; i++ {
+		if err = fn(); err == nil {
+			return nil
+		}
+
+		if i == attempts-1 {
+			break
 		}
+
+		slog.Warn("retrying after failure", "attempt", i+1, "attempts", attempts, "error", err, "delay", delay)
+		time.Sleep(delay)
+		delay *= 2
 	}
 
-	if config.DOAPIToken, ok = os.LookupEnv("DO_API_TOKEN"); !ok {
-		return config, errors.New("DO_API_TOKEN is required")
+	return err
+}
+
+func (c *Config) getPrometheusAPIClient() (*promV1.API, error) {
+	if c.prometheusV1API != nil {
+		return c.prometheusV1API, nil
 	}
 
-	if config.DOAppID, ok = os.LookupEnv("DO_APP_ID"); !ok {
-		return config, errors.New("DO_APP_ID is required")
+	cli, err := promAPI.NewClient(promAPI.Config{Address: c.PrometheusHost})
+	if err != nil {
+		return nil, fmt.Errorf("creating prometheus client: %w", err)
 	}
 
-	if config.PrometheusMetric, ok = os.LookupEnv("PROMETHEUS_METRIC"); !ok {
-		return config, errors.New("PROMETHEUS_METRIC is required")
+	v1api := promV1.NewAPI(cli)
+	c.prometheusV1API = &v1api
+
+	return &v1api, nil
+}
+
+// Get the current value of a metric from Prometheus
+func (c *Config) getMetric(ctx context.Context, serviceName, promQuery string) (float64, error) {
+	timer := prometheus.NewTimer(prometheusQueryDurationSeconds)
+	defer timer.ObserveDuration()
+
+	apiClient, err := c.getPrometheusAPIClient()
+	if err != nil {
+		return 0, err
 	}
 
-	// todo: make optional
-	SimpleWebServer := SimpleWebServer{}
-	SimpleWebServer.start()
+	cli := *apiClient
+	metric := fmt.Sprintf(`scalar(%s)`, promQuery)
+	res, warnings, err := cli.Query(ctx, metric, time.Now())
+	if err != nil {
+		return 0, fmt.Errorf("querying prometheus: %w", err)
+	}
 
-	config.simpleWebServer = &SimpleWebServer
+	if len(warnings) > 0 {
+		slog.Warn("prometheus query returned warnings", "service", serviceName, "warnings", warnings)
+		scaleWarningsTotal.WithLabelValues(serviceName).Inc()
+	}
 
-	return config, nil
+	if res.Type() != model.ValScalar {
+		return 0, errors.New("result is not a scalar value")
+	}
+
+	// read the scalar value
+	scalar, ok := res.(*model.Scalar)
+	if !ok {
+		return 0, errors.New("result is not a scalar value")
+	}
+
+	value := float64(scalar.Value)
+	lastMetricValue.WithLabelValues(serviceName).Set(value)
+
+	return value, nil
 }
 
-func main() {
-	config, err := LoadConfig()
+// getRangeMetric returns every sample of promQuery over the last window,
+// used to guard scaling decisions against a single noisy data point.
+func (c *Config) getRangeMetric(ctx context.Context, serviceName, promQuery string, window time.Duration) ([]float64, error) {
+	timer := prometheus.NewTimer(prometheusQueryDurationSeconds)
+	defer timer.ObserveDuration()
+
+	step := window / 30
+	if step < 15*time.Second {
+		step = 15 * time.Second
+	}
+
+	apiClient, err := c.getPrometheusAPIClient()
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 
-	for {
-		currentValue := config.getMetric()
+	now := time.Now()
+	cli := *apiClient
+	res, warnings, err := cli.QueryRange(ctx, promQuery, promV1.Range{
+		Start: now.Add(-window),
+		End:   now,
+		Step:  step,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("querying prometheus range: %w", err)
+	}
+
+	if len(warnings) > 0 {
+		slog.Warn("prometheus range query returned warnings", "service", serviceName, "warnings", warnings)
+		scaleWarningsTotal.WithLabelValues(serviceName).Inc()
+	}
 
-		log.Printf("Current value: %f\n", currentValue)
+	matrix, ok := res.(model.Matrix)
+	if !ok {
+		return nil, errors.New("range result is not a matrix")
+	}
 
-		if currentValue > config.ThresholdUp {
-			config.scaleUp()
-		} else if currentValue < config.ThresholdDown {
-			config.scaleDown()
+	var values []float64
+	for _, stream := range matrix {
+		for _, point := range stream.Values {
+			values = append(values, float64(point.Value))
 		}
+	}
 
-		log.Printf("Sleeping for 1 minute\n")
+	if len(values) == 0 {
+		return nil, errors.New("no data points in range")
+	}
+
+	return values, nil
+}
 
-		time.Sleep(1 * time.Minute)
+func maxOf(values []float64) float64 {
+	m := values[0]
+	for _, v := range values[1:] {
+		if v > m {
+			m = v
+		}
 	}
+	return m
 }
 
-func (c *Config) getPrometheusAPIClient() *promV1.API {
-	if c.prometheusV1API != nil {
-		return c.prometheusV1API
+func minOf(values []float64) float64 {
+	m := values[0]
+	for _, v := range values[1:] {
+		if v < m {
+			m = v
+		}
 	}
+	return m
+}
 
-	cli, err := promAPI.NewClient(promAPI.Config{Address: c.PrometheusHost})
+func (c *Config) scaleUp(ctx context.Context, target *ScaleTarget, currentValue float64) error {
+	log := slog.With("app_id", c.DOAppID, "service", target.ServiceName)
+
+	entry := AuditEntry{
+		Timestamp:     time.Now(),
+		Service:       target.ServiceName,
+		Action:        "scale_up",
+		MetricValue:   currentValue,
+		ThresholdUp:   target.ThresholdUp,
+		ThresholdDown: target.ThresholdDown,
+		DryRun:        c.DryRun,
+	}
+	defer func() { c.auditLog.Record(entry) }()
+
+	if !target.lastScaleUpTime.IsZero() && time.Since(target.lastScaleUpTime) < target.ScaleUpCooldown {
+		log.Info("scale-up cooldown active, skipping")
+		entry.Reason = "cooldown active"
+		return nil
+	}
+
+	count, err := c.getCurrentAppSize(ctx, target.ServiceName)
 	if err != nil {
-		log.Fatal(err)
+		entry.Error = err.Error()
+		return fmt.Errorf("getting current app size: %w", err)
 	}
+	entry.CurrentSize = count
 
-	v1api := promV1.NewAPI(cli)
-	c.prometheusV1API = &v1api
+	if count >= target.MaxSize {
+		log.Info("already at maximum size")
+		entry.Reason = "already at maximum size"
+		entry.TargetSize = count
+		return nil
+	}
+
+	step := scaleUpStep(count, currentValue, target.ThresholdUp, target.StepUp)
+	newCount := count + step
+	if newCount > target.MaxSize {
+		newCount = target.MaxSize
+	}
+	entry.TargetSize = newCount
+
+	if c.DryRun {
+		log.Info("dry run: would scale up", "action", "scale_up", "current_value", currentValue, "from", count, "to", newCount)
+		entry.Reason = "dry run"
+	} else {
+		log.Info("scaling up", "action", "scale_up", "current_value", currentValue, "from", count, "to", newCount)
+		if err := c.setAppSize(ctx, target.ServiceName, int64(newCount)); err != nil {
+			entry.Error = err.Error()
+			return fmt.Errorf("setting app size: %w", err)
+		}
+		entry.Applied = true
+	}
 
-	return &v1api
+	targetInstanceCount.WithLabelValues(target.ServiceName).Set(float64(newCount))
+	scaleUpEventsTotal.WithLabelValues(target.ServiceName).Inc()
+	target.lastScaleUpTime = time.Now()
+
+	return nil
 }
 
-// Get the current value of the metric from Prometheus
-func (c *Config) getMetric() float64 {
-	cli := *c.getPrometheusAPIClient()
-	metric := fmt.Sprintf(`scalar(%s)`, c.PrometheusMetric)
-	res, warnings, err := cli.Query(context.Background(), metric, time.Now())
+func (c *Config) scaleDown(ctx context.Context, target *ScaleTarget, currentValue float64) error {
+	log := slog.With("app_id", c.DOAppID, "service", target.ServiceName)
+
+	entry := AuditEntry{
+		Timestamp:     time.Now(),
+		Service:       target.ServiceName,
+		Action:        "scale_down",
+		MetricValue:   currentValue,
+		ThresholdUp:   target.ThresholdUp,
+		ThresholdDown: target.ThresholdDown,
+		DryRun:        c.DryRun,
+	}
+	defer func() { c.auditLog.Record(entry) }()
+
+	if !target.lastScaleDownTime.IsZero() && time.Since(target.lastScaleDownTime) < target.ScaleDownCooldown {
+		log.Info("scale-down cooldown active, skipping")
+		entry.Reason = "cooldown active"
+		return nil
+	}
+
+	count, err := c.getCurrentAppSize(ctx, target.ServiceName)
 	if err != nil {
-		log.Fatal(err)
+		entry.Error = err.Error()
+		return fmt.Errorf("getting current app size: %w", err)
 	}
+	entry.CurrentSize = count
 
-	if len(warnings) > 0 {
-		log.Fatal(warnings)
+	if count <= target.MinSize {
+		log.Info("already at minimum size")
+		entry.Reason = "already at minimum size"
+		entry.TargetSize = count
+		return nil
 	}
 
-	if res.Type() != model.ValScalar {
-		log.Fatal("Result is not a scalar value")
+	newCount := count - target.StepDown
+	if newCount < target.MinSize {
+		newCount = target.MinSize
 	}
+	entry.TargetSize = newCount
 
-	// read the scalar value
-	scalar, ok := res.(*model.Scalar)
-	if !ok {
-		log.Fatal("Result is not a scalar value")
+	if c.DryRun {
+		log.Info("dry run: would scale down", "action", "scale_down", "from", count, "to", newCount)
+		entry.Reason = "dry run"
+	} else {
+		log.Info("scaling down", "action", "scale_down", "from", count, "to", newCount)
+		if err := c.setAppSize(ctx, target.ServiceName, int64(newCount)); err != nil {
+			entry.Error = err.Error()
+			return fmt.Errorf("setting app size: %w", err)
+		}
+		entry.Applied = true
 	}
 
-	return float64(scalar.Value)
+	targetInstanceCount.WithLabelValues(target.ServiceName).Set(float64(newCount))
+	scaleDownEventsTotal.WithLabelValues(target.ServiceName).Inc()
+	target.lastScaleDownTime = time.Now()
+
+	return nil
 }
 
-func (c *Config) scaleUp() {
-	ctx := context.Background()
-	count := c.getCurrentAppSize(ctx)
-	if count >= c.MaxSize {
-		log.Printf("Already at maximum size\n")
-		return
+// scaleStepEpsilon absorbs binary float imprecision in the ratio-1
+// computation in scaleUpStep, e.g. 10*(110.0/100.0-1) landing on
+// 1.0000000000000009 instead of 1, so a round-number breach doesn't get
+// ceil'd up to one extra instance.
+const scaleStepEpsilon = 1e-9
+
+// scaleUpStep computes how many instances to add given how far currentValue
+// has exceeded thresholdUp, capped at stepUp.
+func scaleUpStep(count int, currentValue, thresholdUp float64, stepUp int) int {
+	step := 1
+	if thresholdUp > 0 {
+		if ratio := currentValue / thresholdUp; ratio > 1 {
+			if proportional := int(math.Ceil(float64(count)*(ratio-1) - scaleStepEpsilon)); proportional > step {
+				step = proportional
+			}
+		}
 	}
 
-	log.Printf("Scaling up\n")
-	c.setAppSize(ctx, int64(count+1))
+	if step > stepUp {
+		step = stepUp
+	}
+
+	return step
 }
 
-func (c *Config) scaleDown() {
-	ctx := context.Background()
-	count := c.getCurrentAppSize(ctx)
-	if count <= 1 {
-		log.Printf("Already at minimum size\n")
-		return
+// findService returns the service matching serviceName, or the first
+// service when serviceName is empty (preserving single-service behaviour).
+func findService(services []*godo.AppServiceSpec, serviceName string) (*godo.AppServiceSpec, error) {
+	if len(services) == 0 {
+		return nil, fmt.Errorf("no services found")
 	}
 
-	log.Printf("Scaling down\n")
-	c.setAppSize(ctx, int64(count-1))
-}
+	if serviceName == "" {
+		return services[0], nil
+	}
 
-func (c *Config) getCurrentAppSize(ctx context.Context) int {
-	log.Printf("Getting current app size\n")
+	for _, service := range services {
+		if service.GetName() == serviceName {
+			return service, nil
+		}
+	}
+
+	return nil, fmt.Errorf("service %q not found", serviceName)
+}
 
+func (c *Config) getCurrentAppSize(ctx context.Context, serviceName string) (int, error) {
 	cli := c.getDOAPIClient()
 
 	app, _, err := cli.Apps.Get(ctx, c.DOAppID)
 	if err != nil {
-		log.Fatal(fmt.Errorf("Error getting app: %s", err))
+		return 0, fmt.Errorf("getting app: %w", err)
 	}
 
-	services := app.Spec.GetServices()
-	if len(services) == 0 {
-		log.Fatal("No services found")
+	service, err := findService(app.Spec.GetServices(), serviceName)
+	if err != nil {
+		return 0, err
 	}
 
-	service := services[0] // todo: support multiple services
 	size := int(service.GetInstanceCount())
 
-	log.Printf("Current app size: %d\n", size)
+	c.simpleWebServer.recordSize(serviceName, size)
+	currentInstanceCount.WithLabelValues(serviceName).Set(float64(size))
 
-	c.simpleWebServer.lastInstanceSize = size
-	c.simpleWebServer.lastCheck = time.Now()
-
-	return size
+	return size, nil
 }
 
 func (c *Config) getDOAPIClient() *godo.Client {
@@ -242,29 +555,33 @@ func (c *Config) getDOAPIClient() *godo.Client {
 	return c.godoClient
 }
 
-func (c *Config) setAppSize(ctx context.Context, size int64) {
-	log.Printf("Setting app size to %d\n", size)
-
+func (c *Config) setAppSize(ctx context.Context, serviceName string, size int64) error {
 	cli := c.getDOAPIClient()
 
 	app, _, err := cli.Apps.Get(ctx, c.DOAppID)
 	if err != nil {
-		log.Fatal(fmt.Errorf("Error getting app: %s", err))
-	}
-
-	services := app.Spec.GetServices()
-	if len(services) == 0 {
-		log.Fatal("No services found")
+		return fmt.Errorf("getting app: %w", err)
 	}
 
 	newAppSpec := &godo.AppUpdateRequest{
 		Spec: app.Spec,
 	}
 
-	newAppSpec.Spec.Services[0].InstanceCount = size // todo: support multiple services
+	found := false
+	for i, service := range newAppSpec.Spec.Services {
+		if serviceName == "" && i == 0 || service.GetName() == serviceName {
+			newAppSpec.Spec.Services[i].InstanceCount = size
+			found = true
+			break
+		}
+	}
+	if !found {
+		return fmt.Errorf("service %q not found", serviceName)
+	}
 
-	_, _, err = cli.Apps.Update(ctx, c.DOAppID, newAppSpec)
-	if err != nil {
-		log.Fatal(fmt.Errorf("Error updating app: %s", err))
+	if _, _, err = cli.Apps.Update(ctx, c.DOAppID, newAppSpec); err != nil {
+		return fmt.Errorf("updating app: %w", err)
 	}
+
+	return nil
 }
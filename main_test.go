@@ -0,0 +1,80 @@
+package main
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestScaleUpStep(t *testing.T) {
+	tests := []struct {
+		name         string
+		count        int
+		currentValue float64
+		thresholdUp  float64
+		stepUp       int
+		want         int
+	}{
+		{"10% breach on 10", 10, 110, 100, 1000, 1},
+		{"10% breach on 100", 100, 110, 100, 1000, 10},
+		{"30% breach on 20", 20, 130, 100, 1000, 6},
+		{"below threshold", 10, 90, 100, 1000, 1},
+		{"capped by stepUp", 100, 200, 100, 5, 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := scaleUpStep(tt.count, tt.currentValue, tt.thresholdUp, tt.stepUp); got != tt.want {
+				t.Errorf("scaleUpStep(%d, %v, %v, %d) = %d, want %d", tt.count, tt.currentValue, tt.thresholdUp, tt.stepUp, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMinOfMaxOf(t *testing.T) {
+	values := []float64{3, 1, 4, 1, 5, 9, 2, 6}
+
+	if got := minOf(values); got != 1 {
+		t.Errorf("minOf(%v) = %v, want 1", values, got)
+	}
+
+	if got := maxOf(values); got != 9 {
+		t.Errorf("maxOf(%v) = %v, want 9", values, got)
+	}
+}
+
+func TestRetryWithBackoffSucceedsAfterTransientFailures(t *testing.T) {
+	attempts := 0
+	err := retryWithBackoff(3, time.Millisecond, func() error {
+		attempts++
+		if attempts < 3 {
+			return errors.New("transient")
+		}
+		return nil
+	})
+
+	if err != nil {
+		t.Fatalf("retryWithBackoff returned error: %v", err)
+	}
+
+	if attempts != 3 {
+		t.Errorf("attempts = %d, want 3", attempts)
+	}
+}
+
+func TestRetryWithBackoffReturnsLastErrorAfterExhaustingAttempts(t *testing.T) {
+	wantErr := errors.New("still failing")
+	attempts := 0
+	err := retryWithBackoff(2, time.Millisecond, func() error {
+		attempts++
+		return wantErr
+	})
+
+	if !errors.Is(err, wantErr) {
+		t.Errorf("err = %v, want %v", err, wantErr)
+	}
+
+	if attempts != 2 {
+		t.Errorf("attempts = %d, want 2", attempts)
+	}
+}
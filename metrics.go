@@ -0,0 +1,48 @@
+package main
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	currentInstanceCount = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "current_instance_count",
+		Help: "Current number of instances reported by the DigitalOcean App Platform API",
+	}, []string{"service"})
+
+	targetInstanceCount = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "target_instance_count",
+		Help: "Instance count the scaler most recently requested",
+	}, []string{"service"})
+
+	lastMetricValue = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "last_metric_value",
+		Help: "Most recent value of the configured Prometheus scaling metric",
+	}, []string{"service"})
+
+	scaleUpEventsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "scale_up_events_total",
+		Help: "Total number of times the scaler has scaled a service up",
+	}, []string{"service"})
+
+	scaleDownEventsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "scale_down_events_total",
+		Help: "Total number of times the scaler has scaled a service down",
+	}, []string{"service"})
+
+	scaleErrorsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "scale_errors_total",
+		Help: "Total number of errors encountered while scaling, labeled by type",
+	}, []string{"service", "type"})
+
+	scaleWarningsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "scale_warnings_total",
+		Help: "Total number of warnings returned by Prometheus queries",
+	}, []string{"service"})
+
+	prometheusQueryDurationSeconds = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name: "prometheus_query_duration_seconds",
+		Help: "Duration of queries issued against Prometheus",
+	})
+)